@@ -0,0 +1,150 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/HugeSmileDev/WeatherAPI/config"
+)
+
+// Cache status values surfaced via the X-Cache response header.
+const (
+	cacheStatusHit   = "HIT"
+	cacheStatusStale = "STALE"
+	cacheStatusMiss  = "MISS"
+)
+
+// weatherCache is the backend used to persist GetWeather responses. It
+// defaults to a FileCache rooted at WEATHER_CACHE_DIR (or ".cache/weather"),
+// but satisfies the Cache interface so it can be swapped for an in-memory or
+// Redis-backed implementation.
+var weatherCache Cache
+
+func init() {
+	dir := ".cache/weather"
+	if d := os.Getenv("WEATHER_CACHE_DIR"); d != "" {
+		dir = d
+	}
+	weatherCache = NewFileCache(dir)
+}
+
+// CacheEntry is the value stored per cache key: a weather response along
+// with the time it was fetched, used to judge staleness.
+type CacheEntry struct {
+	Response  *WeatherResponse `json:"response"`
+	FetchedAt time.Time        `json:"fetched_at"`
+}
+
+// Cache is the storage interface weather lookups use to persist and
+// retrieve responses. Implementations may back it with memory, the
+// filesystem, or a remote store such as Redis.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry) error
+}
+
+// FileCache is a Cache backed by JSON files on disk, one per key, under Dir.
+type FileCache struct {
+	Dir string
+}
+
+// NewFileCache returns a FileCache that stores entries under dir.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{Dir: dir}
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get reads the cache entry for key, if present on disk.
+func (c *FileCache) Get(key string) (*CacheEntry, bool) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+// Set writes entry for key to disk, creating Dir if necessary.
+func (c *FileCache) Set(key string, entry *CacheEntry) error {
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.path(key), data, 0o644)
+}
+
+// cacheKey builds a stable cache key for a coordinate/units pair, rounding
+// lat/lon so nearby requests share a cache entry.
+func cacheKey(lat, lon float64, units string) string {
+	return fmt.Sprintf("%.2f,%.2f,%s", roundCoord(lat), roundCoord(lon), units)
+}
+
+func roundCoord(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// GetWeatherCached wraps GetWeather with the on-disk cache: a fresh cache
+// entry is served without hitting the network, a stale entry is served
+// immediately while a refresh happens in the background, and a cache miss
+// falls through to a synchronous call to GetWeather.
+func GetWeatherCached(lat, lon float64, units, apiKey string) (weather *WeatherResponse, status string, err error) {
+	key := cacheKey(lat, lon, units)
+	entry, found := weatherCache.Get(key)
+
+	if found && time.Since(entry.FetchedAt) < config.Current().CacheTTL {
+		return entry.Response, cacheStatusHit, nil
+	}
+
+	if found {
+		go refreshWeatherCache(key, lat, lon, units, apiKey)
+		return entry.Response, cacheStatusStale, nil
+	}
+
+	fresh, err := GetWeather(lat, lon, units, apiKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := weatherCache.Set(key, &CacheEntry{Response: fresh, FetchedAt: time.Now()}); err != nil {
+		log.Printf("Error writing weather cache: %v", err)
+	}
+
+	return fresh, cacheStatusMiss, nil
+}
+
+// refreshWeatherCache re-fetches the weather for key and updates the cache.
+// On network failure it leaves the existing stale entry in place so the
+// next request can keep serving it.
+func refreshWeatherCache(key string, lat, lon float64, units, apiKey string) {
+	fresh, err := GetWeather(lat, lon, units, apiKey)
+	if err != nil {
+		log.Printf("Background weather refresh failed for %s: %v", key, err)
+		return
+	}
+
+	if err := weatherCache.Set(key, &CacheEntry{Response: fresh, FetchedAt: time.Now()}); err != nil {
+		log.Printf("Error writing weather cache: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestRoundCoord(t *testing.T) {
+	tests := []struct {
+		name string
+		v    float64
+		want float64
+	}{
+		{name: "rounds down", v: 1.234, want: 1.23},
+		{name: "rounds up", v: 1.236, want: 1.24},
+		{name: "already two decimals", v: 51.5, want: 51.5},
+		{name: "negative", v: -74.0061, want: -74.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundCoord(tt.v); got != tt.want {
+				t.Errorf("roundCoord(%v) = %v, want %v", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		lat   float64
+		lon   float64
+		units string
+		want  string
+	}{
+		{name: "rounds coordinates", lat: 51.5074, lon: -0.1278, units: "metric", want: "51.51,-0.13,metric"},
+		{name: "nearby coordinates share a key", lat: 51.50741, lon: -0.12782, units: "metric", want: "51.51,-0.13,metric"},
+		{name: "different units produce different keys", lat: 51.5074, lon: -0.1278, units: "imperial", want: "51.51,-0.13,imperial"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cacheKey(tt.lat, tt.lon, tt.units); got != tt.want {
+				t.Errorf("cacheKey(%v, %v, %q) = %q, want %q", tt.lat, tt.lon, tt.units, got, tt.want)
+			}
+		})
+	}
+}
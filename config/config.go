@@ -0,0 +1,191 @@
+// Package config resolves application settings once at startup from
+// command-line flags, the process environment, and a .env file, and keeps
+// them up to date by watching the .env file for changes.
+package config
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const envFile = ".env"
+
+// Config holds the settings resolved from flags, environment variables, and
+// the .env file, in that priority order.
+type Config struct {
+	APIKey       string
+	ListenAddr   string
+	CacheTTL     time.Duration
+	LogLevel     string
+	DefaultUnits string
+}
+
+var (
+	apiKeyFlag       = flag.String("api-key", "", "OpenWeather API key (env OPENWEATHER_API_KEY)")
+	listenAddrFlag   = flag.String("listen-addr", "", "HTTP listen address (env LISTEN_ADDR)")
+	cacheTTLFlag     = flag.String("cache-ttl", "", "Weather cache TTL, e.g. 10m (env CACHE_TTL)")
+	logLevelFlag     = flag.String("log-level", "", "Log level (env LOG_LEVEL)")
+	defaultUnitsFlag = flag.String("default-units", "", "Default units: metric, imperial, or standard (env DEFAULT_UNITS)")
+)
+
+var current atomic.Pointer[Config]
+
+// Load resolves the configuration and starts watching the .env file so the
+// API key (and other settings) can be rotated without a restart. It must be
+// called once at startup, after flag parsing is safe (i.e. before any other
+// package calls flag.Parse).
+func Load() (*Config, error) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	cfg, err := resolve()
+	if err != nil {
+		return nil, err
+	}
+	current.Store(cfg)
+
+	go watch()
+
+	return cfg, nil
+}
+
+// Current returns the most recently loaded configuration.
+func Current() *Config {
+	return current.Load()
+}
+
+// resolve builds a Config from flags, the environment, and the .env file.
+func resolve() (*Config, error) {
+	dotEnv := readDotEnv(envFile)
+
+	cfg := &Config{
+		ListenAddr:   ":8080",
+		CacheTTL:     10 * time.Minute,
+		LogLevel:     "info",
+		DefaultUnits: "metric",
+	}
+
+	resolveString(&cfg.APIKey, *apiKeyFlag, "OPENWEATHER_API_KEY", dotEnv)
+	resolveString(&cfg.ListenAddr, *listenAddrFlag, "LISTEN_ADDR", dotEnv)
+	resolveString(&cfg.LogLevel, *logLevelFlag, "LOG_LEVEL", dotEnv)
+	resolveString(&cfg.DefaultUnits, *defaultUnitsFlag, "DEFAULT_UNITS", dotEnv)
+
+	var ttl string
+	resolveString(&ttl, *cacheTTLFlag, "CACHE_TTL", dotEnv)
+	if ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CACHE_TTL %q: %w", ttl, err)
+		}
+		cfg.CacheTTL = d
+	}
+
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("OPENWEATHER_API_KEY not set via flag, environment, or %s", envFile)
+	}
+
+	switch cfg.DefaultUnits {
+	case "metric", "imperial", "standard":
+	default:
+		return nil, fmt.Errorf("invalid DEFAULT_UNITS %q: must be metric, imperial, or standard", cfg.DefaultUnits)
+	}
+
+	return cfg, nil
+}
+
+// resolveString fills dest from, in priority order, an explicit flag value,
+// the process environment, or the .env file.
+func resolveString(dest *string, flagValue, envKey string, dotEnv map[string]string) {
+	if flagValue != "" {
+		*dest = flagValue
+		return
+	}
+	if v := os.Getenv(envKey); v != "" {
+		*dest = v
+		return
+	}
+	if v := dotEnv[envKey]; v != "" {
+		*dest = v
+	}
+}
+
+// readDotEnv parses a simple KEY=VALUE file, ignoring blank lines and lines
+// starting with "#". A missing file yields an empty map.
+func readDotEnv(path string) map[string]string {
+	values := map[string]string{}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return values
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return values
+}
+
+// watch reloads the configuration whenever the .env file is created or
+// written to, atomically swapping it in so readers always see a consistent
+// Config.
+func watch() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("config: failed to start .env watcher: %v", err)
+		return
+	}
+	defer watcher.Close()
+
+	dir := filepath.Dir(envFile)
+	if err := watcher.Add(dir); err != nil {
+		log.Printf("config: failed to watch %s: %v", dir, err)
+		return
+	}
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Base(event.Name) != envFile || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+
+			cfg, err := resolve()
+			if err != nil {
+				log.Printf("config: failed to reload %s: %v", envFile, err)
+				continue
+			}
+			current.Store(cfg)
+			log.Printf("config: reloaded from %s", envFile)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("config: watcher error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveStringPrecedence(t *testing.T) {
+	tests := []struct {
+		name      string
+		flagValue string
+		envValue  string
+		dotEnv    map[string]string
+		want      string
+	}{
+		{name: "flag wins over env and dotenv", flagValue: "from-flag", envValue: "from-env", dotEnv: map[string]string{"KEY": "from-dotenv"}, want: "from-flag"},
+		{name: "env wins over dotenv when flag unset", envValue: "from-env", dotEnv: map[string]string{"KEY": "from-dotenv"}, want: "from-env"},
+		{name: "dotenv used when flag and env unset", dotEnv: map[string]string{"KEY": "from-dotenv"}, want: "from-dotenv"},
+		{name: "empty when nothing set", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.envValue != "" {
+				t.Setenv("KEY", tt.envValue)
+			} else {
+				t.Setenv("KEY", "")
+			}
+
+			var dest string
+			resolveString(&dest, tt.flagValue, "KEY", tt.dotEnv)
+			if dest != tt.want {
+				t.Errorf("resolveString() = %q, want %q", dest, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	dir := t.TempDir()
+	restoreWd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(restoreWd) })
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	resetFlags := func() {
+		*apiKeyFlag = ""
+		*listenAddrFlag = ""
+		*cacheTTLFlag = ""
+		*logLevelFlag = ""
+		*defaultUnitsFlag = ""
+	}
+	t.Cleanup(resetFlags)
+
+	t.Run("dotenv used when flag and env unset", func(t *testing.T) {
+		resetFlags()
+		t.Setenv("OPENWEATHER_API_KEY", "")
+		writeDotEnv(t, dir, "OPENWEATHER_API_KEY=dotenv-key\n")
+
+		cfg, err := resolve()
+		if err != nil {
+			t.Fatalf("resolve() unexpected error: %v", err)
+		}
+		if cfg.APIKey != "dotenv-key" {
+			t.Errorf("APIKey = %q, want %q", cfg.APIKey, "dotenv-key")
+		}
+	})
+
+	t.Run("env overrides dotenv", func(t *testing.T) {
+		resetFlags()
+		t.Setenv("OPENWEATHER_API_KEY", "env-key")
+		writeDotEnv(t, dir, "OPENWEATHER_API_KEY=dotenv-key\n")
+
+		cfg, err := resolve()
+		if err != nil {
+			t.Fatalf("resolve() unexpected error: %v", err)
+		}
+		if cfg.APIKey != "env-key" {
+			t.Errorf("APIKey = %q, want %q", cfg.APIKey, "env-key")
+		}
+	})
+
+	t.Run("flag overrides env and dotenv", func(t *testing.T) {
+		resetFlags()
+		*apiKeyFlag = "flag-key"
+		t.Setenv("OPENWEATHER_API_KEY", "env-key")
+		writeDotEnv(t, dir, "OPENWEATHER_API_KEY=dotenv-key\n")
+
+		cfg, err := resolve()
+		if err != nil {
+			t.Fatalf("resolve() unexpected error: %v", err)
+		}
+		if cfg.APIKey != "flag-key" {
+			t.Errorf("APIKey = %q, want %q", cfg.APIKey, "flag-key")
+		}
+	})
+
+	t.Run("missing api key is an error", func(t *testing.T) {
+		resetFlags()
+		t.Setenv("OPENWEATHER_API_KEY", "")
+		os.Remove(filepath.Join(dir, envFile))
+
+		if _, err := resolve(); err == nil {
+			t.Fatal("resolve() expected error when no API key is configured, got nil")
+		}
+	})
+}
+
+func writeDotEnv(t *testing.T, dir, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, envFile), []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/HugeSmileDev/WeatherAPI/config"
+)
+
+// ForecastResponse struct to unmarshal the JSON response from OpenWeather's
+// 5 day / 3 hour forecast API.
+type ForecastResponse struct {
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			Temp float64 `json:"temp"`
+		} `json:"main"`
+		Weather []struct {
+			Main        string `json:"main"`
+			Description string `json:"description"`
+		} `json:"weather"`
+	} `json:"list"`
+	City struct {
+		Name string `json:"name"`
+	} `json:"city"`
+}
+
+// GetForecast function fetches the five day / three hour interval forecast
+// from OpenWeather's /data/2.5/forecast endpoint.
+func GetForecast(lat, lon float64, units, apiKey string) (*ForecastResponse, error) {
+	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/forecast?lat=%f&lon=%f&units=%s&APPID=%s", lat, lon, units, apiKey)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	forecastResponse := &ForecastResponse{}
+	err = json.Unmarshal(body, forecastResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return forecastResponse, nil
+}
+
+// ForecastHandler handles incoming HTTP requests for the five day forecast
+func ForecastHandler(w http.ResponseWriter, r *http.Request) {
+	// Log incoming request
+	log.Printf("Incoming request from %s for %s", r.RemoteAddr, r.URL.Path)
+
+	// Parse the units query parameter (metric, imperial, standard)
+	units, err := parseUnits(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	apiKey := config.Current().APIKey
+
+	// Resolve coordinates from lat/lon, city, or zip query parameters
+	latFloat, lonFloat, err := resolveCoordinates(r, apiKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.Printf("Error resolving location: %v", err)
+		return
+	}
+
+	// Get forecast data
+	forecast, err := GetForecast(latFloat, lonFloat, units, apiKey)
+	if err != nil {
+		http.Error(w, "Failed to fetch forecast data", http.StatusInternalServerError)
+		return
+	}
+
+	// Construct response: one line per 3-hour interval
+	unit := temperatureUnit(units)
+	var b strings.Builder
+	fmt.Fprintf(&b, "5-day forecast for %s:\n", forecast.City.Name)
+	for _, entry := range forecast.List {
+		condition := "unknown"
+		if len(entry.Weather) > 0 {
+			condition = entry.Weather[0].Main
+		}
+		fmt.Fprintf(&b, "%s: %.1f%s, %s\n", time.Unix(entry.Dt, 0).UTC().Format(time.RFC3339), entry.Main.Temp, unit, condition)
+	}
+	response := b.String()
+
+	// Log response
+	log.Printf("Response sent for request from %s", r.RemoteAddr)
+
+	// Send response
+	fmt.Fprint(w, response)
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// geoCacheTTL is how long a resolved city/zip lookup is reused before the
+// Geocoding API is queried again.
+const geoCacheTTL = 1 * time.Hour
+
+// geoCacheEntry holds a resolved coordinate pair along with its expiry time.
+type geoCacheEntry struct {
+	lat, lon float64
+	expires  time.Time
+}
+
+var (
+	geoCacheMu sync.Mutex
+	geoCache   = map[string]geoCacheEntry{}
+)
+
+// geocodeResult mirrors a single entry returned by OpenWeather's
+// /geo/1.0/direct endpoint.
+type geocodeResult struct {
+	Name    string  `json:"name"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	Country string  `json:"country"`
+}
+
+// zipGeocodeResult mirrors the object returned by OpenWeather's
+// /geo/1.0/zip endpoint.
+type zipGeocodeResult struct {
+	Zip     string  `json:"zip"`
+	Name    string  `json:"name"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	Country string  `json:"country"`
+}
+
+// GetLocation resolves a free-form city query (optionally "city,country") to
+// coordinates via OpenWeather's Geocoding API, caching the result in-process.
+func GetLocation(query, apiKey string) (lat, lon float64, err error) {
+	key := "direct:" + normalizeGeoQuery(query)
+	if cached, ok := lookupGeoCache(key); ok {
+		return cached.lat, cached.lon, nil
+	}
+
+	apiURL := fmt.Sprintf("https://api.openweathermap.org/geo/1.0/direct?q=%s&limit=1&appid=%s", url.QueryEscape(query), apiKey)
+	resp, err := http.Get(apiURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("no location found for %q (status %d)", query, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var results []geocodeResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		return 0, 0, err
+	}
+	if len(results) == 0 {
+		return 0, 0, fmt.Errorf("no location found for %q", query)
+	}
+
+	storeGeoCache(key, results[0].Lat, results[0].Lon)
+	return results[0].Lat, results[0].Lon, nil
+}
+
+// GetZipLocation resolves a ZIP/postal code (and ISO 3166 country code) to
+// coordinates via OpenWeather's Geocoding API, caching the result in-process.
+func GetZipLocation(zip, country, apiKey string) (lat, lon float64, err error) {
+	if country == "" {
+		country = "US"
+	}
+	key := "zip:" + normalizeGeoQuery(zip+","+country)
+	if cached, ok := lookupGeoCache(key); ok {
+		return cached.lat, cached.lon, nil
+	}
+
+	apiURL := fmt.Sprintf("https://api.openweathermap.org/geo/1.0/zip?zip=%s,%s&appid=%s", url.QueryEscape(zip), url.QueryEscape(country), apiKey)
+	resp, err := http.Get(apiURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("no location found for zip %q (status %d)", zip, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var result zipGeocodeResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return 0, 0, err
+	}
+	if result.Lat == 0 && result.Lon == 0 {
+		return 0, 0, fmt.Errorf("no location found for zip %q", zip)
+	}
+
+	storeGeoCache(key, result.Lat, result.Lon)
+	return result.Lat, result.Lon, nil
+}
+
+// normalizeGeoQuery canonicalizes a geocoding query so equivalent requests
+// (differing only in case or surrounding whitespace) share a cache entry.
+func normalizeGeoQuery(query string) string {
+	return strings.ToLower(strings.TrimSpace(query))
+}
+
+func lookupGeoCache(key string) (geoCacheEntry, bool) {
+	geoCacheMu.Lock()
+	defer geoCacheMu.Unlock()
+
+	entry, ok := geoCache[key]
+	if !ok || time.Now().After(entry.expires) {
+		return geoCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func storeGeoCache(key string, lat, lon float64) {
+	geoCacheMu.Lock()
+	defer geoCacheMu.Unlock()
+
+	geoCache[key] = geoCacheEntry{lat: lat, lon: lon, expires: time.Now().Add(geoCacheTTL)}
+}
+
+// resolveCoordinates determines the coordinates for a request: raw lat/lon
+// query parameters take precedence, falling back to a city or zip lookup
+// through the Geocoding API.
+func resolveCoordinates(r *http.Request, apiKey string) (lat, lon float64, err error) {
+	q := r.URL.Query()
+
+	if zip := q.Get("zip"); zip != "" {
+		return GetZipLocation(zip, q.Get("country"), apiKey)
+	}
+
+	if city := q.Get("city"); city != "" {
+		query := city
+		if country := q.Get("country"); country != "" {
+			query = city + "," + country
+		}
+		return GetLocation(query, apiKey)
+	}
+
+	latStr := q.Get("lat")
+	lonStr := q.Get("lon")
+	if latStr == "" || lonStr == "" {
+		return 0, 0, fmt.Errorf("must provide lat/lon, city, or zip")
+	}
+
+	lat, err = strconv.ParseFloat(latStr, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid latitude: %w", err)
+	}
+	lon, err = strconv.ParseFloat(lonStr, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid longitude: %w", err)
+	}
+	return lat, lon, nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeGeoQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{name: "lowercases", query: "London", want: "london"},
+		{name: "trims whitespace", query: "  Paris,FR  ", want: "paris,fr"},
+		{name: "already normalized", query: "tokyo,jp", want: "tokyo,jp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeGeoQuery(tt.query); got != tt.want {
+				t.Errorf("normalizeGeoQuery(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeoCacheTTLEviction(t *testing.T) {
+	const key = "direct:test-eviction-key"
+
+	storeGeoCache(key, 1.5, 2.5)
+	if _, ok := lookupGeoCache(key); !ok {
+		t.Fatalf("expected freshly stored entry to be found")
+	}
+
+	geoCacheMu.Lock()
+	entry := geoCache[key]
+	entry.expires = time.Now().Add(-time.Second)
+	geoCache[key] = entry
+	geoCacheMu.Unlock()
+
+	if _, ok := lookupGeoCache(key); ok {
+		t.Fatalf("expected expired entry to be evicted from cache lookup")
+	}
+}
@@ -1,31 +1,137 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
-	"strconv"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/HugeSmileDev/WeatherAPI/config"
+	pb "github.com/HugeSmileDev/WeatherAPI/proto"
 )
 
 // WeatherResponse struct to unmarshal JSON response from OpenWeather API
 type WeatherResponse struct {
+	Coord struct {
+		Lon float64 `json:"lon"`
+		Lat float64 `json:"lat"`
+	} `json:"coord"`
 	Weather []struct {
 		Main        string `json:"main"`
 		Description string `json:"description"`
+		Icon        string `json:"icon"`
 	} `json:"weather"`
 	Main struct {
-		Temp float64 `json:"temp"`
+		Temp      float64 `json:"temp"`
+		FeelsLike float64 `json:"feels_like"`
+		TempMin   float64 `json:"temp_min"`
+		TempMax   float64 `json:"temp_max"`
+		Pressure  float64 `json:"pressure"`
+		Humidity  float64 `json:"humidity"`
 	} `json:"main"`
+	Visibility int `json:"visibility"`
+	Wind       struct {
+		Speed float64 `json:"speed"`
+		Deg   float64 `json:"deg"`
+		Gust  float64 `json:"gust"`
+	} `json:"wind"`
+	Clouds struct {
+		All int `json:"all"`
+	} `json:"clouds"`
+	Rain struct {
+		OneHour   float64 `json:"1h"`
+		ThreeHour float64 `json:"3h"`
+	} `json:"rain"`
+	Snow struct {
+		OneHour   float64 `json:"1h"`
+		ThreeHour float64 `json:"3h"`
+	} `json:"snow"`
+	Sys struct {
+		Country string `json:"country"`
+		Sunrise int64  `json:"sunrise"`
+		Sunset  int64  `json:"sunset"`
+	} `json:"sys"`
+	Timezone int    `json:"timezone"`
+	Name     string `json:"name"`
+}
+
+// weatherAPIResponse is the JSON shape served for /weather requests.
+type weatherAPIResponse struct {
+	Coord struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	} `json:"coord"`
+	Weather []struct {
+		Main        string `json:"main"`
+		Description string `json:"description"`
+		Icon        string `json:"icon"`
+	} `json:"weather"`
+	Temperature struct {
+		Temp      float64 `json:"temp"`
+		FeelsLike float64 `json:"feels_like"`
+		Min       float64 `json:"min"`
+		Max       float64 `json:"max"`
+		Unit      string  `json:"unit"`
+	} `json:"temperature"`
+	Humidity float64 `json:"humidity"`
+	Pressure float64 `json:"pressure"`
+	Wind     struct {
+		Speed float64 `json:"speed"`
+		Deg   float64 `json:"deg"`
+		Gust  float64 `json:"gust"`
+	} `json:"wind"`
+	Clouds     int       `json:"clouds"`
+	Visibility int       `json:"visibility"`
+	Sunrise    time.Time `json:"sunrise"`
+	Sunset     time.Time `json:"sunset"`
+}
+
+// buildWeatherAPIResponse shapes the raw OpenWeather payload into the JSON
+// response served to API clients, converting sunrise/sunset to local time
+// using the location's UTC offset.
+func buildWeatherAPIResponse(weather *WeatherResponse, units string) weatherAPIResponse {
+	var res weatherAPIResponse
+
+	res.Coord.Lat = weather.Coord.Lat
+	res.Coord.Lon = weather.Coord.Lon
+	res.Weather = weather.Weather
+	res.Temperature.Temp = weather.Main.Temp
+	res.Temperature.FeelsLike = weather.Main.FeelsLike
+	res.Temperature.Min = weather.Main.TempMin
+	res.Temperature.Max = weather.Main.TempMax
+	res.Temperature.Unit = temperatureUnit(units)
+	res.Humidity = weather.Main.Humidity
+	res.Pressure = weather.Main.Pressure
+	res.Wind.Speed = weather.Wind.Speed
+	res.Wind.Deg = weather.Wind.Deg
+	res.Wind.Gust = weather.Wind.Gust
+	res.Clouds = weather.Clouds.All
+	res.Visibility = weather.Visibility
+	res.Sunrise = time.Unix(weather.Sys.Sunrise+int64(weather.Timezone), 0).UTC()
+	res.Sunset = time.Unix(weather.Sys.Sunset+int64(weather.Timezone), 0).UTC()
+
+	return res
+}
+
+// wantsPlainText reports whether the request's Accept header asks for the
+// plain-text summary rather than the default JSON response.
+func wantsPlainText(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/plain")
 }
 
 // GetWeather function fetches weather data from OpenWeather API
-func GetWeather(lat, lon float64, apiKey string) (*WeatherResponse, error) {
-	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/weather?lat=%f&lon=%f&units=metric&APPID=%s", lat, lon, apiKey)
+func GetWeather(lat, lon float64, units, apiKey string) (*WeatherResponse, error) {
+	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/weather?lat=%f&lon=%f&units=%s&APPID=%s", lat, lon, units, apiKey)
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, err
@@ -51,91 +157,168 @@ func WeatherHandler(w http.ResponseWriter, r *http.Request) {
 	// Log incoming request
 	log.Printf("Incoming request from %s for %s", r.RemoteAddr, r.URL.Path)
 
-	// Parse latitude and longitude from query parameters
-	lat := r.URL.Query().Get("lat")
-	lon := r.URL.Query().Get("lon")
-
-	// Convert latitude and longitude to float64
-	latFloat, err := strconv.ParseFloat(lat, 64)
+	// Parse the units query parameter (metric, imperial, standard)
+	units, err := parseUnits(r)
 	if err != nil {
-		http.Error(w, "Invalid latitude", http.StatusBadRequest)
-		log.Printf("Error parsing latitude: %v", err)
-		return
-	}
-	lonFloat, err := strconv.ParseFloat(lon, 64)
-	if err != nil {
-		http.Error(w, "Invalid longitude", http.StatusBadRequest)
-		log.Printf("Error parsing longitude: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	// Load API key from environment file
-	apiKey, err := loadAPIKey()
+	apiKey := config.Current().APIKey
+
+	// Resolve coordinates from lat/lon, city, or zip query parameters
+	latFloat, lonFloat, err := resolveCoordinates(r, apiKey)
 	if err != nil {
-		http.Error(w, "Failed to load API key", http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.Printf("Error resolving location: %v", err)
 		return
 	}
 
-	// Get weather data
-	weather, err := GetWeather(latFloat, lonFloat, apiKey)
+	// Get weather data, serving from cache where possible
+	weather, cacheStatus, err := GetWeatherCached(latFloat, lonFloat, units, apiKey)
 	if err != nil {
 		http.Error(w, "Failed to fetch weather data", http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set("X-Cache", cacheStatus)
 
-	// Determine weather condition based on temperature
-	var weatherCondition string
-	temperature := weather.Main.Temp
-	switch {
-	case temperature >= 30:
-		weatherCondition = "hot"
-	case temperature <= 10:
-		weatherCondition = "cold"
-	default:
-		weatherCondition = "moderate"
+	if wantsPlainText(r) {
+		temperature := weather.Main.Temp
+		weatherCondition := classifyCondition(temperature, units)
+
+		condition := "unknown"
+		if len(weather.Weather) > 0 {
+			condition = weather.Weather[0].Main
+		}
+
+		response := fmt.Sprintf("Weather: %s, Temperature: %.1f%s, Condition: %s", condition, temperature, temperatureUnit(units), weatherCondition)
+
+		log.Printf("Response sent for request from %s: %s", r.RemoteAddr, response)
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprint(w, response)
+		return
 	}
 
-	// Construct response
-	response := fmt.Sprintf("Weather: %s, Temperature: %.1f°C, Condition: %s", weather.Weather[0].Main, temperature, weatherCondition)
+	// Default: rich structured JSON response
+	apiResponse := buildWeatherAPIResponse(weather, units)
 
-	// Log response
-	log.Printf("Response sent for request from %s: %s", r.RemoteAddr, response)
+	log.Printf("Response sent for request from %s", r.RemoteAddr)
 
-	// Send response
-	fmt.Fprintf(w, response)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(apiResponse); err != nil {
+		log.Printf("Error encoding response: %v", err)
+	}
 }
 
-// loadAPIKey loads the API key from the environment file
-func loadAPIKey() (string, error) {
-	file, err := os.Open(".env")
-	if err != nil {
-		return "", err
+// parseUnits reads the "units" query parameter and validates it against the
+// values OpenWeather accepts, defaulting to the configured DefaultUnits when unset.
+func parseUnits(r *http.Request) (string, error) {
+	units := r.URL.Query().Get("units")
+	if units == "" {
+		return config.Current().DefaultUnits, nil
 	}
-	defer file.Close()
+	switch units {
+	case "metric", "imperial", "standard":
+		return units, nil
+	default:
+		return "", fmt.Errorf("invalid units %q: must be metric, imperial, or standard", units)
+	}
+}
 
-	var apiKey string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(line, "OPENWEATHER_API_KEY=") {
-			apiKey = strings.TrimPrefix(line, "OPENWEATHER_API_KEY=")
-			break
-		}
+// temperatureUnit returns the display suffix for the given OpenWeather units value.
+func temperatureUnit(units string) string {
+	switch units {
+	case "imperial":
+		return "°F"
+	case "standard":
+		return "K"
+	default:
+		return "°C"
 	}
-	if apiKey == "" {
-		return "", fmt.Errorf("API key not found in .env file")
+}
+
+// classifyCondition buckets a temperature into hot/cold/moderate, converting
+// to Celsius first so the thresholds are meaningful regardless of units.
+func classifyCondition(temp float64, units string) string {
+	celsius := temp
+	switch units {
+	case "imperial":
+		celsius = (temp - 32) * 5 / 9
+	case "standard":
+		celsius = temp - 273.15
 	}
 
-	return apiKey, nil
+	switch {
+	case celsius >= 30:
+		return "hot"
+	case celsius <= 10:
+		return "cold"
+	default:
+		return "moderate"
+	}
 }
 
 func main() {
 	// Set up logging
 	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
 
-	// Start the HTTP server
-	addr := ":8080"
-	log.Printf("Server is running on %s", addr)
-	http.HandleFunc("/weather", WeatherHandler)
-	log.Fatal(http.ListenAndServe(addr, nil))
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if cfg.LogLevel == "debug" {
+		log.SetFlags(log.LstdFlags | log.Lmicroseconds | log.Lshortfile)
+	}
+
+	httpAddr := cfg.ListenAddr
+	grpcAddr := ":9090"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/weather", WeatherHandler)
+	mux.HandleFunc("/forecast", ForecastHandler)
+	httpServer := &http.Server{Addr: httpAddr, Handler: mux}
+
+	grpcListener, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", grpcAddr, err)
+	}
+	grpcServerInstance := grpc.NewServer()
+	pb.RegisterWeatherServiceServer(grpcServerInstance, &grpcServer{})
+
+	errCh := make(chan error, 2)
+
+	go func() {
+		log.Printf("HTTP server is running on %s", httpAddr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("HTTP server error: %w", err)
+		}
+	}()
+
+	go func() {
+		log.Printf("gRPC server is running on %s", grpcAddr)
+		if err := grpcServerInstance.Serve(grpcListener); err != nil {
+			errCh <- fmt.Errorf("gRPC server error: %w", err)
+		}
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case sig := <-sigCh:
+		log.Printf("Received signal %s, shutting down", sig)
+	case err := <-errCh:
+		log.Printf("Server error: %v", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Error shutting down HTTP server: %v", err)
+	}
+	grpcServerInstance.GracefulStop()
+
+	log.Println("Shutdown complete")
 }
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/HugeSmileDev/WeatherAPI/config"
+)
+
+func TestMain(m *testing.M) {
+	os.Setenv("OPENWEATHER_API_KEY", "test-key")
+	if _, err := config.Load(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{name: "defaults to metric when unset", query: "", want: "metric"},
+		{name: "accepts metric", query: "units=metric", want: "metric"},
+		{name: "accepts imperial", query: "units=imperial", want: "imperial"},
+		{name: "accepts standard", query: "units=standard", want: "standard"},
+		{name: "rejects unknown units", query: "units=bogus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/weather?"+tt.query, nil)
+			got, err := parseUnits(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseUnits(%q) = %q, nil; want error", tt.query, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseUnits(%q) unexpected error: %v", tt.query, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseUnits(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemperatureUnit(t *testing.T) {
+	tests := []struct {
+		units string
+		want  string
+	}{
+		{units: "metric", want: "°C"},
+		{units: "imperial", want: "°F"},
+		{units: "standard", want: "K"},
+	}
+
+	for _, tt := range tests {
+		if got := temperatureUnit(tt.units); got != tt.want {
+			t.Errorf("temperatureUnit(%q) = %q, want %q", tt.units, got, tt.want)
+		}
+	}
+}
+
+func TestClassifyCondition(t *testing.T) {
+	tests := []struct {
+		name  string
+		temp  float64
+		units string
+		want  string
+	}{
+		{name: "hot in metric", temp: 31, units: "metric", want: "hot"},
+		{name: "cold in metric", temp: 5, units: "metric", want: "cold"},
+		{name: "moderate in metric", temp: 20, units: "metric", want: "moderate"},
+		// 60°F is about 15.6°C: moderate, not the "hot" a raw imperial threshold would give.
+		{name: "moderate in imperial despite raw value looking hot", temp: 60, units: "imperial", want: "moderate"},
+		// 86°F is 30°C: hot.
+		{name: "hot in imperial", temp: 86, units: "imperial", want: "hot"},
+		// 283.15K is 10°C: cold.
+		{name: "cold in standard", temp: 283.15, units: "standard", want: "cold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyCondition(tt.temp, tt.units); got != tt.want {
+				t.Errorf("classifyCondition(%v, %q) = %q, want %q", tt.temp, tt.units, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HugeSmileDev/WeatherAPI/config"
+	pb "github.com/HugeSmileDev/WeatherAPI/proto"
+)
+
+// grpcServer implements the generated WeatherServiceServer, delegating to the
+// same GetWeather/GetForecast/GetLocation functions backing the HTTP handlers.
+type grpcServer struct {
+	pb.UnimplementedWeatherServiceServer
+}
+
+// unitsString maps the Units enum to the string OpenWeather's API expects.
+func unitsString(u pb.Units) string {
+	switch u {
+	case pb.Units_IMPERIAL:
+		return "imperial"
+	case pb.Units_STANDARD:
+		return "standard"
+	default:
+		return "metric"
+	}
+}
+
+// resolveRPCLocation resolves the oneof location variant shared by
+// WeatherRequest and LocationRequest to coordinates.
+func resolveRPCLocation(cityQuery *pb.CityQuery, zipQuery *pb.ZipQuery, latLon *pb.LatLon, apiKey string) (lat, lon float64, err error) {
+	switch {
+	case cityQuery != nil:
+		query := cityQuery.GetCity()
+		if country := cityQuery.GetCountry(); country != "" {
+			query = query + "," + country
+		}
+		return GetLocation(query, apiKey)
+	case zipQuery != nil:
+		return GetZipLocation(zipQuery.GetZip(), zipQuery.GetCountry(), apiKey)
+	case latLon != nil:
+		return latLon.GetLat(), latLon.GetLon(), nil
+	default:
+		return 0, 0, fmt.Errorf("must provide a city, zip, or lat_lon location")
+	}
+}
+
+// Current implements pb.WeatherServiceServer.
+func (s *grpcServer) Current(ctx context.Context, req *pb.WeatherRequest) (*pb.CurrentResponse, error) {
+	apiKey := config.Current().APIKey
+
+	lat, lon, err := resolveRPCLocation(req.GetCityQuery(), req.GetZipQuery(), req.GetLatLon(), apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	units := unitsString(req.GetUnits())
+	weather, _, err := GetWeatherCached(lat, lon, units, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CurrentResponse{
+		Lat:        weather.Coord.Lat,
+		Lon:        weather.Coord.Lon,
+		Humidity:   weather.Main.Humidity,
+		Pressure:   weather.Main.Pressure,
+		Clouds:     int32(weather.Clouds.All),
+		Visibility: int32(weather.Visibility),
+		Sunrise:    weather.Sys.Sunrise + int64(weather.Timezone),
+		Sunset:     weather.Sys.Sunset + int64(weather.Timezone),
+		Temperature: &pb.Temperature{
+			Temp:      weather.Main.Temp,
+			FeelsLike: weather.Main.FeelsLike,
+			Min:       weather.Main.TempMin,
+			Max:       weather.Main.TempMax,
+			Unit:      temperatureUnit(units),
+		},
+		Wind: &pb.Wind{
+			Speed: weather.Wind.Speed,
+			Deg:   weather.Wind.Deg,
+			Gust:  weather.Wind.Gust,
+		},
+	}
+	for _, cond := range weather.Weather {
+		resp.Weather = append(resp.Weather, &pb.WeatherCondition{
+			Main:        cond.Main,
+			Description: cond.Description,
+			Icon:        cond.Icon,
+		})
+	}
+
+	return resp, nil
+}
+
+// Forecast implements pb.WeatherServiceServer.
+func (s *grpcServer) Forecast(ctx context.Context, req *pb.WeatherRequest) (*pb.ForecastResponse, error) {
+	apiKey := config.Current().APIKey
+
+	lat, lon, err := resolveRPCLocation(req.GetCityQuery(), req.GetZipQuery(), req.GetLatLon(), apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := GetForecast(lat, lon, unitsString(req.GetUnits()), apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ForecastResponse{CityName: forecast.City.Name}
+	for _, entry := range forecast.List {
+		condition := "unknown"
+		if len(entry.Weather) > 0 {
+			condition = entry.Weather[0].Main
+		}
+		resp.Entries = append(resp.Entries, &pb.ForecastEntry{
+			Dt:        entry.Dt,
+			Temp:      entry.Main.Temp,
+			Condition: condition,
+		})
+	}
+
+	return resp, nil
+}
+
+// Location implements pb.WeatherServiceServer.
+func (s *grpcServer) Location(ctx context.Context, req *pb.LocationRequest) (*pb.LocationResponse, error) {
+	apiKey := config.Current().APIKey
+
+	lat, lon, err := resolveRPCLocation(req.GetCityQuery(), req.GetZipQuery(), nil, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LocationResponse{Lat: lat, Lon: lon}, nil
+}